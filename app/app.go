@@ -2,25 +2,36 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/Lysoul/gocommon/ginserver"
 	"github.com/Lysoul/gocommon/monitoring"
+	"github.com/Lysoul/gocommon/postgres"
+	"github.com/Lysoul/todolist/internal/todo"
+	"github.com/Lysoul/todolist/pkg/httperr"
+	"github.com/Lysoul/todolist/pkg/migrate"
+	"github.com/Lysoul/todolist/pkg/observability"
 	"github.com/gin-gonic/gin"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
 )
 
 //nolint:gochecknoglobals // we need this for versioning
 var Version = "unknown"
 
 type Config struct {
-	HTTP ginserver.Config
-	// Postgres postgres.Config // will use it later
+	HTTP     ginserver.Config
+	Postgres postgres.Config
+	Trace    observability.Config
 
-	ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"20s"`
+	ShutdownTimeout  time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"20s"`
+	ReadyCacheWindow time.Duration `envconfig:"READY_CACHE_WINDOW" default:"5s"`
 }
 
 func Start() error {
@@ -28,8 +39,28 @@ func Start() error {
 
 	var config Config
 	envconfig.MustProcess("", &config)
+	config.Trace.ServiceVersion = Version
+
+	otelProvider, err := observability.Init(context.Background(), config.Trace)
+	if err != nil {
+		return fmt.Errorf("initializing observability: %w", err)
+	}
+
+	db, err := postgres.Open(config.Postgres)
+	if err != nil {
+		return fmt.Errorf("opening postgres connection: %w", err)
+	}
+	db.AddQueryHook(observability.NewQueryHook(config.Trace.ServiceName))
+
+	if config.Postgres.Migrate {
+		if _, err := migrate.New(db).Up(context.Background(), false); err != nil {
+			return fmt.Errorf("applying migrations: %w", err)
+		}
+	}
 
 	router, httpStart := ginserver.InitGin(config.HTTP, log)
+	router.Use(observability.GinMiddleware(config.Trace.ServiceName))
+	router.Use(httperr.Recovery())
 	basePath := config.HTTP.Prefix
 	router.GET(basePath+"/version", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -37,6 +68,14 @@ func Start() error {
 		})
 	})
 
+	var shuttingDown atomic.Bool
+
+	ready := newReadinessCache(db, config.ReadyCacheWindow)
+	router.GET(basePath+"/healthz", func(c *gin.Context) {
+		c.Status(200)
+	})
+	router.GET(basePath+"/readyz", readyzHandler(&shuttingDown, ready))
+
 	apiGroup := router.Group(basePath)
 
 	apiGroup.GET("/hello", func(ctx *gin.Context) {
@@ -45,18 +84,49 @@ func Start() error {
 		})
 	})
 
+	todo.RegisterRoutes(apiGroup, todo.NewRepository(db))
+
 	_, httpStop := httpStart()
-	monitoring.ServeTelemetry(3030)
+	telemetryStop := monitoring.ServeTelemetry(3030)
+
+	lifecycle := NewLifecycle()
+	lifecycle.OnShutdownStart(func() { shuttingDown.Store(true) })
+	lifecycle.Register(Component{
+		Name:      "http",
+		DependsOn: []string{"postgres", "trace"},
+		Stop:      httpStop,
+	})
+	lifecycle.Register(Component{
+		Name:      "telemetry",
+		DependsOn: []string{"trace"},
+		Stop:      telemetryStop,
+	})
+	lifecycle.Register(Component{
+		Name:      "postgres",
+		DependsOn: []string{"trace"},
+		Stop:      func(context.Context) error { return db.Close() },
+	})
+	lifecycle.Register(Component{
+		Name: "trace",
+		Stop: otelProvider.Shutdown,
+	})
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Info("Shutting down server...")
 
-	ctx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
-	defer cancel()
+	report := lifecycle.Shutdown(context.Background(), config.ShutdownTimeout)
+	for _, c := range report.Components {
+		if c.ExceededBudget {
+			log.Warn("shutdown component exceeded its budget",
+				zap.String("component", c.Name), zap.Duration("duration", c.Duration))
+		}
+	}
 
-	httpStop(ctx)
+	if errs := report.Errors(); len(errs) > 0 {
+		return cli.NewMultiError(errs...)
+	}
 
 	return nil
 }
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Component is a subsystem the Lifecycle knows how to stop: the HTTP
+// server, the Postgres pool, the trace exporter, a background worker, etc.
+// DependsOn names components that must still be running while this one
+// stops (e.g. the HTTP server depends on the DB pool draining in-flight
+// requests before the pool closes), so Shutdown stops dependents before
+// their dependencies.
+type Component struct {
+	Name      string
+	Stop      func(ctx context.Context) error
+	DependsOn []string
+	// Timeout is this component's slice of the overall shutdown budget.
+	// Zero means "whatever is left".
+	Timeout time.Duration
+}
+
+// ComponentReport records how a single component's stop call went.
+type ComponentReport struct {
+	Name           string
+	Duration       time.Duration
+	Err            error
+	ExceededBudget bool
+}
+
+// ShutdownReport is returned by Lifecycle.Shutdown once every component
+// has been given a chance to stop.
+type ShutdownReport struct {
+	Components []ComponentReport
+}
+
+// Errors returns every component error, wrapped with the component's name.
+func (r ShutdownReport) Errors() []error {
+	var errs []error
+	for _, c := range r.Components {
+		if c.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Name, c.Err))
+		}
+	}
+	return errs
+}
+
+// Lifecycle coordinates graceful shutdown across registered Components,
+// stopping them in reverse dependency order within a shared timeout
+// budget, and runs hooks the moment shutdown begins (before anything is
+// actually stopped) so a readiness probe can flip to "not ready" while
+// in-flight work still drains.
+type Lifecycle struct {
+	mu              sync.Mutex
+	components      []Component
+	onShutdownStart []func()
+}
+
+// NewLifecycle returns an empty Lifecycle ready for Register calls.
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// Register adds c to the set of components stopped by Shutdown.
+func (l *Lifecycle) Register(c Component) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.components = append(l.components, c)
+}
+
+// OnShutdownStart registers fn to run as soon as Shutdown is called, before
+// any component is stopped.
+func (l *Lifecycle) OnShutdownStart(fn func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.onShutdownStart = append(l.onShutdownStart, fn)
+}
+
+// Shutdown stops every registered component in reverse dependency order,
+// carving each one's Timeout (or whatever remains of budget) out of the
+// overall budget, and returns a report of how each one went.
+func (l *Lifecycle) Shutdown(ctx context.Context, budget time.Duration) ShutdownReport {
+	l.mu.Lock()
+	hooks := append([]func(){}, l.onShutdownStart...)
+	order := reverseDependencyOrder(l.components)
+	l.mu.Unlock()
+
+	for _, fn := range hooks {
+		fn()
+	}
+
+	var report ShutdownReport
+	remaining := budget
+
+	for _, c := range order {
+		componentBudget := c.Timeout
+		if componentBudget == 0 || componentBudget > remaining {
+			componentBudget = remaining
+		}
+
+		stopCtx, cancel := context.WithTimeout(ctx, componentBudget)
+		start := time.Now()
+		err := c.Stop(stopCtx)
+		duration := time.Since(start)
+		cancel()
+
+		remaining -= duration
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		report.Components = append(report.Components, ComponentReport{
+			Name:           c.Name,
+			Duration:       duration,
+			Err:            err,
+			ExceededBudget: duration > componentBudget,
+		})
+	}
+
+	return report
+}
+
+// reverseDependencyOrder topologically sorts components so that any
+// component is stopped before the components it depends on (DependsOn
+// lists what must still be alive while this component stops). A dependency
+// name that was never registered is ignored, and a cycle is broken where
+// the walk reaches a component it is already visiting, so every component
+// still appears exactly once.
+func reverseDependencyOrder(components []Component) []Component {
+	byName := make(map[string]Component, len(components))
+	for _, c := range components {
+		byName[c.Name] = c
+	}
+
+	var order []Component
+	visited := make(map[string]bool, len(components))
+	visiting := make(map[string]bool, len(components))
+
+	var visit func(c Component)
+	visit = func(c Component) {
+		if visited[c.Name] || visiting[c.Name] {
+			return
+		}
+		visiting[c.Name] = true
+		for _, depName := range c.DependsOn {
+			if dep, ok := byName[depName]; ok {
+				visit(dep)
+			}
+		}
+		visiting[c.Name] = false
+		visited[c.Name] = true
+		order = append(order, c)
+	}
+
+	for _, c := range components {
+		visit(c)
+	}
+
+	// visit() walks dependencies before the component itself, i.e. it
+	// builds start order (DB before the HTTP server that depends on it).
+	// Shutdown wants the opposite: stop the HTTP server before the DB
+	// pool it depends on, so reverse it here.
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	return order
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleShutdownOrder(t *testing.T) {
+	t.Run("stops dependents before their dependencies", func(t *testing.T) {
+		var stopped []string
+
+		l := NewLifecycle()
+		l.Register(Component{
+			Name:      "db",
+			DependsOn: nil,
+			Stop: func(_ context.Context) error {
+				stopped = append(stopped, "db")
+				return nil
+			},
+		})
+		l.Register(Component{
+			Name:      "http",
+			DependsOn: []string{"db"},
+			Stop: func(_ context.Context) error {
+				stopped = append(stopped, "http")
+				return nil
+			},
+		})
+
+		l.Shutdown(context.Background(), time.Second)
+
+		require.Equal(t, []string{"http", "db"}, stopped)
+	})
+
+	t.Run("runs shutdown-start hooks before stopping anything", func(t *testing.T) {
+		var events []string
+
+		l := NewLifecycle()
+		l.OnShutdownStart(func() { events = append(events, "hook") })
+		l.Register(Component{
+			Name: "http",
+			Stop: func(_ context.Context) error {
+				events = append(events, "http")
+				return nil
+			},
+		})
+
+		l.Shutdown(context.Background(), time.Second)
+
+		require.Equal(t, []string{"hook", "http"}, events)
+	})
+
+	t.Run("collects errors and records them per component", func(t *testing.T) {
+		l := NewLifecycle()
+		l.Register(Component{
+			Name: "http",
+			Stop: func(_ context.Context) error { return errors.New("boom") },
+		})
+
+		report := l.Shutdown(context.Background(), time.Second)
+
+		errs := report.Errors()
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "http")
+		assert.Contains(t, errs[0].Error(), "boom")
+	})
+
+	t.Run("flags a component that exceeds its budget", func(t *testing.T) {
+		l := NewLifecycle()
+		l.Register(Component{
+			Name:    "slow",
+			Timeout: time.Millisecond,
+			Stop: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		})
+
+		report := l.Shutdown(context.Background(), time.Second)
+
+		require.Len(t, report.Components, 1)
+		assert.True(t, report.Components[0].ExceededBudget)
+	})
+}
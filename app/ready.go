@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Lysoul/todolist/db/migrations"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+// readinessCache memoizes the result of a readiness check for a short
+// window so probe storms from an orchestrator don't hammer the database.
+// probe is injected so tests can exercise the window/caching behavior
+// without a real database.
+type readinessCache struct {
+	window time.Duration
+	probe  func(ctx context.Context) (bool, error)
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	ready     bool
+	err       error
+}
+
+func newReadinessCache(db *bun.DB, window time.Duration) *readinessCache {
+	return &readinessCache{
+		window: window,
+		probe:  func(ctx context.Context) (bool, error) { return probeDB(ctx, db) },
+	}
+}
+
+func (c *readinessCache) check(ctx context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.checkedAt) < c.window {
+		return c.ready, c.err
+	}
+
+	c.ready, c.err = c.probe(ctx)
+	c.checkedAt = time.Now()
+
+	return c.ready, c.err
+}
+
+func probeDB(ctx context.Context, db *bun.DB) (bool, error) {
+	pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	if err := db.PingContext(pingCtx); err != nil {
+		return false, err
+	}
+
+	status, err := migrations.CheckStatus(ctx, db)
+	if err != nil {
+		return false, err
+	}
+
+	return status.Ready(), nil
+}
+
+// readyzHandler reports whether the service is ready to take traffic: not
+// mid-shutdown, and the cached readiness probe passing.
+func readyzHandler(shuttingDown *atomic.Bool, ready *readinessCache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if shuttingDown.Load() {
+			c.JSON(503, gin.H{"ready": false, "reason": "shutting down"})
+			return
+		}
+
+		isReady, err := ready.check(c.Request.Context())
+		if !isReady {
+			c.JSON(503, gin.H{"ready": false, "reason": errString(err)})
+			return
+		}
+
+		c.JSON(200, gin.H{"ready": true})
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return "migrations pending"
+	}
+	return err.Error()
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessCacheWindow(t *testing.T) {
+	t.Run("caches the result within the window", func(t *testing.T) {
+		var calls int32
+		c := &readinessCache{
+			window: time.Minute,
+			probe: func(context.Context) (bool, error) {
+				atomic.AddInt32(&calls, 1)
+				return true, nil
+			},
+		}
+
+		_, _ = c.check(context.Background())
+		_, _ = c.check(context.Background())
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls),
+			"second check within the window should reuse the cached result")
+	})
+
+	t.Run("reprobes once the window elapses", func(t *testing.T) {
+		var calls int32
+		c := &readinessCache{
+			window: time.Millisecond,
+			probe: func(context.Context) (bool, error) {
+				atomic.AddInt32(&calls, 1)
+				return true, nil
+			},
+		}
+
+		_, _ = c.check(context.Background())
+		time.Sleep(10 * time.Millisecond)
+		_, _ = c.check(context.Background())
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("surfaces the probe's ready/err result", func(t *testing.T) {
+		wantErr := errors.New("not migrated")
+		c := &readinessCache{
+			probe: func(context.Context) (bool, error) { return false, wantErr },
+		}
+
+		ready, err := c.check(context.Background())
+		assert.False(t, ready)
+		assert.ErrorIs(t, err, wantErr)
+	})
+}
+
+func TestReadyzHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		shuttingDown bool
+		probe        func(context.Context) (bool, error)
+		wantStatus   int
+	}{
+		{
+			name:         "ready",
+			shuttingDown: false,
+			probe:        func(context.Context) (bool, error) { return true, nil },
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "not ready",
+			shuttingDown: false,
+			probe:        func(context.Context) (bool, error) { return false, errors.New("migrations pending") },
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:         "shutting down takes priority over the probe",
+			shuttingDown: true,
+			probe:        func(context.Context) (bool, error) { return true, nil },
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var shuttingDown atomic.Bool
+			shuttingDown.Store(tc.shuttingDown)
+
+			ready := &readinessCache{probe: tc.probe}
+
+			router := gin.New()
+			router.GET("/readyz", readyzHandler(&shuttingDown, ready))
+
+			req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
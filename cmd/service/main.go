@@ -4,9 +4,9 @@ import (
 	"log"
 	"os"
 
-	"github.com/Lysoul/gocommon/postgres"
 	"github.com/Lysoul/todolist/app"
 	"github.com/Lysoul/todolist/db/migrations"
+	"github.com/Lysoul/todolist/pkg/migrate"
 	"github.com/urfave/cli/v2"
 )
 
@@ -16,7 +16,8 @@ func main() {
 		Usage: "A simple todo app",
 		Commands: []*cli.Command{
 			app.CliCommand(),
-			postgres.CliCommand(migrations.Migration),
+			migrations.CliCommand(),
+			migrate.CliCommand(),
 		},
 	}
 	err := cli.Run(os.Args)
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/Lysoul/gocommon/postgres"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/uptrace/bun/migrate"
+	"github.com/urfave/cli/v2"
+)
+
+// CliCommand exposes diagnostic subcommands for inspecting the embedded
+// migration set against whatever database POSTGRES_URL points at.
+func CliCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate-list",
+		Usage: "Show pending vs applied migrations",
+		Action: func(c *cli.Context) error {
+			return runMigrateList(c.Context)
+		},
+	}
+}
+
+func runMigrateList(ctx context.Context) error {
+	var pgConfig postgres.Config
+	envconfig.MustProcess("", &pgConfig)
+
+	db, err := postgres.Open(pgConfig)
+	if err != nil {
+		return fmt.Errorf("migrate-list: connecting to database: %w", err)
+	}
+	defer db.Close()
+
+	migrator := migrate.NewMigrator(db, Migration)
+	if err := migrator.Init(ctx); err != nil {
+		return fmt.Errorf("migrate-list: initializing migrator: %w", err)
+	}
+
+	applied, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate-list: fetching migration status: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT\tGROUP ID")
+
+	for _, m := range applied {
+		appliedAt := "pending"
+		if !m.MigratedAt.IsZero() {
+			appliedAt = m.MigratedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\n", m.ID, m.Name, appliedAt, m.GroupID)
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("migrate-list: writing output: %w", err)
+	}
+
+	status, err := CheckStatus(ctx, db)
+	if err != nil {
+		return fmt.Errorf("migrate-list: checking drift: %w", err)
+	}
+	if status.Drifted {
+		return fmt.Errorf("migrate-list: database has applied migrations missing from the embedded filesystem")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCliCommand(t *testing.T) {
+	t.Run("returns valid command structure", func(t *testing.T) {
+		cmd := CliCommand()
+
+		require.NotNil(t, cmd, "CliCommand should return non-nil command")
+		assert.Equal(t, "migrate-list", cmd.Name, "command name should be 'migrate-list'")
+		assert.NotEmpty(t, cmd.Usage, "command usage should be set")
+		assert.NotNil(t, cmd.Action, "command should have an action")
+	})
+
+	t.Run("command creation is stable across calls", func(t *testing.T) {
+		cmd1 := CliCommand()
+		cmd2 := CliCommand()
+
+		assert.Equal(t, cmd1.Name, cmd2.Name)
+		assert.Equal(t, cmd1.Usage, cmd2.Usage)
+	})
+}
@@ -30,15 +30,15 @@ func TestMigrationInitialization(t *testing.T) {
 		entries, err := sqlMigrations.ReadDir(".")
 		require.NoError(t, err, "should be able to read directory")
 		
-		// Check that test.sql is present
+		// Check that 0001_create_todos.sql is present
 		found := false
 		for _, entry := range entries {
-			if entry.Name() == "test.sql" {
+			if entry.Name() == "0001_create_todos.sql" {
 				found = true
-				assert.False(t, entry.IsDir(), "test.sql should be a file, not a directory")
+				assert.False(t, entry.IsDir(), "0001_create_todos.sql should be a file, not a directory")
 			}
 		}
-		assert.True(t, found, "test.sql should be embedded in the filesystem")
+		assert.True(t, found, "0001_create_todos.sql should be embedded in the filesystem")
 	})
 
 	t.Run("Migration discovery completes without panic", func(t *testing.T) {
@@ -77,11 +77,9 @@ func TestMigrationDiscovery(t *testing.T) {
 	})
 
 	t.Run("can read SQL file content", func(t *testing.T) {
-		content, err := sqlMigrations.ReadFile("test.sql")
-		assert.NoError(t, err, "should be able to read test.sql")
-		assert.NotNil(t, content, "content should not be nil")
-		// test.sql is empty in the current state
-		assert.Equal(t, 0, len(content), "test.sql is currently empty")
+		content, err := sqlMigrations.ReadFile("0001_create_todos.sql")
+		assert.NoError(t, err, "should be able to read 0001_create_todos.sql")
+		assert.NotEmpty(t, content, "0001_create_todos.sql should contain the todos table definition")
 	})
 }
 
@@ -199,7 +197,7 @@ func TestMigrationConcurrency(t *testing.T) {
 				_, err := sqlMigrations.ReadDir(".")
 				assert.NoError(t, err)
 				
-				_, err = sqlMigrations.ReadFile("test.sql")
+				_, err = sqlMigrations.ReadFile("0001_create_todos.sql")
 				assert.NoError(t, err)
 			}()
 		}
@@ -229,7 +227,7 @@ func BenchmarkReadEmbeddedFS(b *testing.B) {
 	b.Run("ReadFile", func(b *testing.B) {
 		b.ReportAllocs()
 		for i := 0; i < b.N; i++ {
-			_, _ = sqlMigrations.ReadFile("test.sql")
+			_, _ = sqlMigrations.ReadFile("0001_create_todos.sql")
 		}
 	})
 }
\ No newline at end of file
@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+)
+
+// Status reports how the applied migrations in a database compare to the
+// embedded migration set.
+type Status struct {
+	// Pending holds embedded migrations that have not been applied yet.
+	Pending migrate.MigrationSlice
+	// Drifted is true when the database has an applied migration that is
+	// no longer present in the embedded filesystem.
+	Drifted bool
+}
+
+// Ready reports whether the database is fully migrated: nothing pending and
+// no drift against the embedded migration set.
+func (s Status) Ready() bool {
+	return len(s.Pending) == 0 && !s.Drifted
+}
+
+// CheckStatus compares db's applied migrations against the embedded
+// migration set. It is shared by the migrate-list CLI command and the
+// /readyz HTTP handler so both report drift the same way.
+func CheckStatus(ctx context.Context, db *bun.DB) (Status, error) {
+	migrator := migrate.NewMigrator(db, Migration)
+	if err := migrator.Init(ctx); err != nil {
+		return Status{}, fmt.Errorf("migrations: initializing migrator: %w", err)
+	}
+
+	applied, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return Status{}, fmt.Errorf("migrations: fetching migration status: %w", err)
+	}
+
+	var status Status
+	for _, m := range applied {
+		if m.MigratedAt.IsZero() {
+			status.Pending = append(status.Pending, m)
+		}
+	}
+
+	// MigrationsWithStatus only reports status for the migrations it was
+	// constructed with, so it can never surface a row that's in
+	// bun_migrations but missing from the embedded set. Query the table
+	// directly and diff it against what we have embedded instead - by name,
+	// not id: a freshly Discover()'d Migration never gets a DB-assigned id
+	// (only the copies MigrationsWithStatus/Migrate build and return do), so
+	// every entry in Migration.Sorted() has id 0 and can't be the join key.
+	var appliedNames []string
+	if err := db.NewSelect().Table("bun_migrations").Column("name").Scan(ctx, &appliedNames); err != nil {
+		return Status{}, fmt.Errorf("migrations: fetching applied migration names: %w", err)
+	}
+
+	embedded := make(map[string]bool, len(Migration.Sorted()))
+	for _, m := range Migration.Sorted() {
+		embedded[m.Name] = true
+	}
+
+	for _, name := range appliedNames {
+		if !embedded[name] {
+			status.Drifted = true
+			break
+		}
+	}
+
+	return status, nil
+}
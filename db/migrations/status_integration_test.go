@@ -0,0 +1,76 @@
+//go:build integration
+
+package migrations
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Lysoul/gocommon/postgres"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+)
+
+// TestCheckStatusNotDriftedAfterCleanMigrate hits a real Postgres instance
+// (POSTGRES_URL) and confirms a normally-migrated database - every embedded
+// migration applied, nothing extra - reports Drifted == false. This is the
+// common case migrate-list and /readyz see on a healthy deployment, and it
+// must not be confused with drift just because the applied rows' ids don't
+// match the zero-value ids on the freshly discovered Migration set.
+func TestCheckStatusNotDriftedAfterCleanMigrate(t *testing.T) {
+	db := openIntegrationDB(t)
+	ctx := context.Background()
+
+	migrator := migrate.NewMigrator(db, Migration)
+	require.NoError(t, migrator.Init(ctx))
+	require.NoError(t, migrator.Lock(ctx))
+	_, err := migrator.Migrate(ctx)
+	migrator.Unlock(ctx) //nolint:errcheck
+	require.NoError(t, err)
+
+	status, err := CheckStatus(ctx, db)
+	require.NoError(t, err)
+	require.False(t, status.Drifted)
+}
+
+// TestCheckStatusDetectsDrift hits a real Postgres instance (POSTGRES_URL)
+// and confirms CheckStatus notices a bun_migrations row that isn't in the
+// embedded migration set, not just pending embedded migrations.
+func TestCheckStatusDetectsDrift(t *testing.T) {
+	db := openIntegrationDB(t)
+	ctx := context.Background()
+
+	migrator := migrate.NewMigrator(db, Migration)
+	require.NoError(t, migrator.Init(ctx))
+	require.NoError(t, migrator.Lock(ctx))
+	defer migrator.Unlock(ctx) //nolint:errcheck
+
+	_, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+
+	_, err = db.NewInsert().Model(&migrate.Migration{ID: 9999999999, Name: "drifted_migration"}).
+		Table("bun_migrations").Exec(ctx)
+	require.NoError(t, err)
+	defer db.NewDelete().Table("bun_migrations").Where("id = 9999999999").Exec(ctx) //nolint:errcheck
+
+	status, err := CheckStatus(ctx, db)
+	require.NoError(t, err)
+	require.True(t, status.Drifted)
+}
+
+func openIntegrationDB(t *testing.T) *bun.DB {
+	t.Helper()
+
+	url := os.Getenv("POSTGRES_URL")
+	if url == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+
+	db, err := postgres.Open(postgres.Config{URL: url})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
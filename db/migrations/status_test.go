@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusReady(t *testing.T) {
+	t.Run("ready when nothing pending and no drift", func(t *testing.T) {
+		s := Status{}
+		assert.True(t, s.Ready())
+	})
+
+	t.Run("not ready when migrations are pending", func(t *testing.T) {
+		s := Status{Pending: Migration.Sorted()}
+		if len(s.Pending) == 0 {
+			t.Skip("no embedded migrations to mark as pending")
+		}
+		assert.False(t, s.Ready())
+	})
+
+	t.Run("not ready when drifted", func(t *testing.T) {
+		s := Status{Drifted: true}
+		assert.False(t, s.Ready())
+	})
+}
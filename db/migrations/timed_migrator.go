@@ -0,0 +1,113 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Lysoul/gocommon/monitoring"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+	"go.uber.org/zap"
+)
+
+const defaultSlowThreshold = 30 * time.Second
+
+// TimedMigrator runs the embedded migrations one at a time instead of as a
+// single batch, logging a start/done pair around each one so a hung
+// migration is visible in production logs rather than a silent batch. It
+// backs every real migration run: pkg/migrate's "migrate up" CLI command
+// and app.Start()'s POSTGRES_MIGRATE startup path both apply migrations
+// through this type rather than a bare bun/migrate.Migrator.
+type TimedMigrator struct {
+	db            *bun.DB
+	log           *zap.Logger
+	slowThreshold time.Duration
+}
+
+// NewTimedMigrator builds a TimedMigrator for db. The slow-migration
+// threshold defaults to 30s and can be overridden with the
+// MIGRATION_SLOW_THRESHOLD env var (parsed as a time.Duration, e.g. "1m").
+func NewTimedMigrator(db *bun.DB) *TimedMigrator {
+	threshold := defaultSlowThreshold
+	if v := os.Getenv("MIGRATION_SLOW_THRESHOLD"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			threshold = d
+		}
+	}
+
+	return &TimedMigrator{
+		db:            db,
+		log:           monitoring.Logger(),
+		slowThreshold: threshold,
+	}
+}
+
+// Migrate runs every unapplied migration, one file at a time, and returns
+// the combined group once all of them have been applied.
+func (t *TimedMigrator) Migrate(ctx context.Context) (*migrate.MigrationGroup, error) {
+	migrator := migrate.NewMigrator(t.db, Migration)
+	if err := migrator.Init(ctx); err != nil {
+		return nil, fmt.Errorf("timed migrator: init: %w", err)
+	}
+
+	pending, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("timed migrator: fetching status: %w", err)
+	}
+	pending = pending.Unapplied()
+
+	group := &migrate.MigrationGroup{}
+	for _, m := range pending {
+		mgroup, err := t.runOne(ctx, m)
+		if err != nil {
+			return group, err
+		}
+		group.Migrations = append(group.Migrations, mgroup.Migrations...)
+	}
+
+	return group, nil
+}
+
+func (t *TimedMigrator) runOne(ctx context.Context, m migrate.Migration) (*migrate.MigrationGroup, error) {
+	single := migrate.NewMigrations()
+	single.Add(m)
+
+	migrator := migrate.NewMigrator(t.db, single)
+	if err := migrator.Init(ctx); err != nil {
+		return nil, fmt.Errorf("timed migrator: init %s: %w", m.Name, err)
+	}
+
+	t.log.Info("migration.start", zap.String("name", m.Name), zap.Int64("version", m.ID))
+	start := time.Now()
+
+	mgroup, err := migrator.Migrate(ctx)
+	duration := time.Since(start)
+	if err != nil {
+		t.log.Error("migration.failed",
+			zap.String("name", m.Name),
+			zap.Int64("version", m.ID),
+			zap.Duration("duration", duration),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("timed migrator: running %s: %w", m.Name, err)
+	}
+
+	t.log.Info("migration.done",
+		zap.String("name", m.Name),
+		zap.Int64("version", m.ID),
+		zap.Int64("duration_ms", duration.Milliseconds()),
+	)
+
+	if duration > t.slowThreshold {
+		t.log.Warn("migration.slow",
+			zap.String("name", m.Name),
+			zap.Int64("version", m.ID),
+			zap.Duration("duration", duration),
+			zap.Duration("threshold", t.slowThreshold),
+		)
+	}
+
+	return mgroup, nil
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimedMigrator(t *testing.T) {
+	t.Run("defaults slow threshold to 30s", func(t *testing.T) {
+		t.Setenv("MIGRATION_SLOW_THRESHOLD", "")
+
+		tm := NewTimedMigrator(nil)
+
+		require.NotNil(t, tm)
+		assert.Equal(t, 30*time.Second, tm.slowThreshold)
+	})
+
+	t.Run("honors MIGRATION_SLOW_THRESHOLD", func(t *testing.T) {
+		t.Setenv("MIGRATION_SLOW_THRESHOLD", "1m")
+
+		tm := NewTimedMigrator(nil)
+
+		require.NotNil(t, tm)
+		assert.Equal(t, time.Minute, tm.slowThreshold)
+	})
+
+	t.Run("ignores an invalid threshold", func(t *testing.T) {
+		t.Setenv("MIGRATION_SLOW_THRESHOLD", "not-a-duration")
+
+		tm := NewTimedMigrator(nil)
+
+		require.NotNil(t, tm)
+		assert.Equal(t, 30*time.Second, tm.slowThreshold)
+	})
+}
@@ -0,0 +1,173 @@
+package todo
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Lysoul/todolist/pkg/httperr"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type createRequest struct {
+	Title       string     `json:"title" binding:"required"`
+	Description string     `json:"description"`
+	Status      Status     `json:"status"`
+	DueAt       *time.Time `json:"due_at"`
+}
+
+type updateRequest struct {
+	Title       *string    `json:"title"`
+	Description *string    `json:"description"`
+	Status      *Status    `json:"status"`
+	DueAt       *time.Time `json:"due_at"`
+}
+
+// RegisterRoutes wires the todo CRUD handlers onto group, backed by repo.
+func RegisterRoutes(group *gin.RouterGroup, repo Repository) {
+	group.POST("/todos", httperr.Wrap(createHandler(repo)))
+	group.GET("/todos", httperr.Wrap(listHandler(repo)))
+	group.GET("/todos/:id", httperr.Wrap(getHandler(repo)))
+	group.PATCH("/todos/:id", httperr.Wrap(updateHandler(repo)))
+	group.DELETE("/todos/:id", httperr.Wrap(deleteHandler(repo)))
+}
+
+func createHandler(repo Repository) httperr.HandlerFunc {
+	return func(c *gin.Context) error {
+		var req createRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			return httperr.Validation(err.Error(), nil)
+		}
+
+		if req.Status != "" && !req.Status.Valid() {
+			return httperr.Validation("status must be one of pending, in_progress, done", nil)
+		}
+
+		t := &Todo{
+			Title:       req.Title,
+			Description: req.Description,
+			Status:      req.Status,
+			DueAt:       req.DueAt,
+		}
+
+		if err := repo.Create(c.Request.Context(), t); err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusCreated, t)
+		return nil
+	}
+}
+
+func listHandler(repo Repository) httperr.HandlerFunc {
+	return func(c *gin.Context) error {
+		filter := ListFilter{Status: Status(c.Query("status"))}
+
+		if filter.Status != "" && !filter.Status.Valid() {
+			return httperr.Validation("status must be one of pending, in_progress, done", nil)
+		}
+
+		if limit, err := strconv.Atoi(c.Query("limit")); err == nil {
+			filter.Limit = limit
+		}
+		if offset, err := strconv.Atoi(c.Query("offset")); err == nil {
+			filter.Offset = offset
+		}
+
+		todos, err := repo.List(c.Request.Context(), filter)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, gin.H{"todos": todos})
+		return nil
+	}
+}
+
+func getHandler(repo Repository) httperr.HandlerFunc {
+	return func(c *gin.Context) error {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			return httperr.Validation("id must be a valid UUID", nil)
+		}
+
+		t, err := repo.Get(c.Request.Context(), id)
+		if errors.Is(err, ErrNotFound) {
+			return httperr.NotFound("todo", id.String())
+		}
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, t)
+		return nil
+	}
+}
+
+func updateHandler(repo Repository) httperr.HandlerFunc {
+	return func(c *gin.Context) error {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			return httperr.Validation("id must be a valid UUID", nil)
+		}
+
+		var req updateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			return httperr.Validation(err.Error(), nil)
+		}
+
+		if req.Status != nil && !req.Status.Valid() {
+			return httperr.Validation("status must be one of pending, in_progress, done", nil)
+		}
+
+		t, err := repo.Get(c.Request.Context(), id)
+		if errors.Is(err, ErrNotFound) {
+			return httperr.NotFound("todo", id.String())
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.Title != nil {
+			t.Title = *req.Title
+		}
+		if req.Description != nil {
+			t.Description = *req.Description
+		}
+		if req.Status != nil {
+			t.Status = *req.Status
+		}
+		if req.DueAt != nil {
+			t.DueAt = req.DueAt
+		}
+
+		if err := repo.Update(c.Request.Context(), t); err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, t)
+		return nil
+	}
+}
+
+func deleteHandler(repo Repository) httperr.HandlerFunc {
+	return func(c *gin.Context) error {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			return httperr.Validation("id must be a valid UUID", nil)
+		}
+
+		err = repo.Delete(c.Request.Context(), id)
+		if errors.Is(err, ErrNotFound) {
+			return httperr.NotFound("todo", id.String())
+		}
+		if err != nil {
+			return err
+		}
+
+		c.Status(http.StatusNoContent)
+		return nil
+	}
+}
@@ -0,0 +1,260 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepository struct {
+	createFn func(ctx context.Context, t *Todo) error
+	listFn   func(ctx context.Context, filter ListFilter) ([]Todo, error)
+	getFn    func(ctx context.Context, id uuid.UUID) (*Todo, error)
+	updateFn func(ctx context.Context, t *Todo) error
+	deleteFn func(ctx context.Context, id uuid.UUID) error
+}
+
+func (f *fakeRepository) Create(ctx context.Context, t *Todo) error {
+	return f.createFn(ctx, t)
+}
+
+func (f *fakeRepository) List(ctx context.Context, filter ListFilter) ([]Todo, error) {
+	return f.listFn(ctx, filter)
+}
+
+func (f *fakeRepository) Get(ctx context.Context, id uuid.UUID) (*Todo, error) {
+	return f.getFn(ctx, id)
+}
+
+func (f *fakeRepository) Update(ctx context.Context, t *Todo) error {
+	return f.updateFn(ctx, t)
+}
+
+func (f *fakeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return f.deleteFn(ctx, id)
+}
+
+func newTestRouter(repo Repository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	RegisterRoutes(router.Group(""), repo)
+	return router
+}
+
+func TestCreateHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		createFn   func(ctx context.Context, t *Todo) error
+		wantStatus int
+	}{
+		{
+			name: "creates a todo",
+			body: `{"title":"buy milk"}`,
+			createFn: func(_ context.Context, t *Todo) error {
+				t.ID = uuid.New()
+				return nil
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "missing title is rejected",
+			body:       `{"description":"no title"}`,
+			createFn:   func(_ context.Context, _ *Todo) error { return nil },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid status is rejected",
+			body:       `{"title":"x","status":"bogus"}`,
+			createFn:   func(_ context.Context, _ *Todo) error { return nil },
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeRepository{createFn: tc.createFn}
+			router := newTestRouter(repo)
+
+			req := httptest.NewRequest(http.MethodPost, "/todos", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestCreateHandlerThreadsDueAt(t *testing.T) {
+	dueAt := time.Date(2026, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	var got *Todo
+	repo := &fakeRepository{
+		createFn: func(_ context.Context, t *Todo) error {
+			t.ID = uuid.New()
+			got = t
+			return nil
+		},
+	}
+	router := newTestRouter(repo)
+
+	body, err := json.Marshal(map[string]any{"title": "ship it", "due_at": dueAt})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/todos", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NotNil(t, got.DueAt)
+	assert.True(t, dueAt.Equal(*got.DueAt))
+}
+
+func TestUpdateHandlerThreadsDueAt(t *testing.T) {
+	id := uuid.New()
+	dueAt := time.Date(2026, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	var got *Todo
+	repo := &fakeRepository{
+		getFn: func(_ context.Context, id uuid.UUID) (*Todo, error) {
+			return &Todo{ID: id, Title: "ship it"}, nil
+		},
+		updateFn: func(_ context.Context, t *Todo) error {
+			got = t
+			return nil
+		},
+	}
+	router := newTestRouter(repo)
+
+	body, err := json.Marshal(map[string]any{"due_at": dueAt})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPatch, "/todos/"+id.String(), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, got.DueAt)
+	assert.True(t, dueAt.Equal(*got.DueAt))
+}
+
+func TestGetHandler(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		path       string
+		getFn      func(ctx context.Context, id uuid.UUID) (*Todo, error)
+		wantStatus int
+	}{
+		{
+			name: "found",
+			path: "/todos/" + id.String(),
+			getFn: func(_ context.Context, id uuid.UUID) (*Todo, error) {
+				return &Todo{ID: id, Title: "buy milk"}, nil
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "not found",
+			path: "/todos/" + id.String(),
+			getFn: func(_ context.Context, _ uuid.UUID) (*Todo, error) {
+				return nil, ErrNotFound
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "invalid id",
+			path:       "/todos/not-a-uuid",
+			getFn:      func(_ context.Context, _ uuid.UUID) (*Todo, error) { return nil, nil },
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeRepository{getFn: tc.getFn}
+			router := newTestRouter(repo)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestListHandler(t *testing.T) {
+	repo := &fakeRepository{
+		listFn: func(_ context.Context, filter ListFilter) ([]Todo, error) {
+			assert.Equal(t, StatusDone, filter.Status)
+			return []Todo{{Title: "done one", Status: StatusDone}}, nil
+		},
+	}
+	router := newTestRouter(repo)
+
+	req := httptest.NewRequest(http.MethodGet, "/todos?status=done", nil)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Todos []Todo `json:"todos"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Len(t, body.Todos, 1)
+}
+
+func TestDeleteHandler(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		deleteFn   func(ctx context.Context, id uuid.UUID) error
+		wantStatus int
+	}{
+		{
+			name:       "deletes a todo",
+			deleteFn:   func(_ context.Context, _ uuid.UUID) error { return nil },
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "not found",
+			deleteFn:   func(_ context.Context, _ uuid.UUID) error { return ErrNotFound },
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeRepository{deleteFn: tc.deleteFn}
+			router := newTestRouter(repo)
+
+			req := httptest.NewRequest(http.MethodDelete, "/todos/"+id.String(), nil)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package todo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ErrNotFound is returned when a Todo does not exist.
+var ErrNotFound = errors.New("todo: not found")
+
+// ListFilter narrows down the todos returned by Repository.List.
+type ListFilter struct {
+	Status Status
+	Limit  int
+	Offset int
+}
+
+// Repository persists and retrieves todos.
+type Repository interface {
+	Create(ctx context.Context, t *Todo) error
+	List(ctx context.Context, filter ListFilter) ([]Todo, error)
+	Get(ctx context.Context, id uuid.UUID) (*Todo, error)
+	Update(ctx context.Context, t *Todo) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type bunRepository struct {
+	db *bun.DB
+}
+
+// NewRepository returns a Repository backed by db.
+func NewRepository(db *bun.DB) Repository {
+	return &bunRepository{db: db}
+}
+
+func (r *bunRepository) Create(ctx context.Context, t *Todo) error {
+	now := time.Now()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	if t.Status == "" {
+		t.Status = StatusPending
+	}
+
+	_, err := r.db.NewInsert().Model(t).Exec(ctx)
+	return err
+}
+
+func (r *bunRepository) List(ctx context.Context, filter ListFilter) ([]Todo, error) {
+	var todos []Todo
+
+	q := r.db.NewSelect().Model(&todos).OrderExpr("created_at DESC")
+
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		q = q.Offset(filter.Offset)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+func (r *bunRepository) Get(ctx context.Context, id uuid.UUID) (*Todo, error) {
+	t := new(Todo)
+	err := r.db.NewSelect().Model(t).Where("id = ?", id).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *bunRepository) Update(ctx context.Context, t *Todo) error {
+	t.UpdatedAt = time.Now()
+
+	res, err := r.db.NewUpdate().Model(t).WherePK().Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res)
+}
+
+func (r *bunRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.NewDelete().Model((*Todo)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(res)
+}
+
+func checkRowsAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+//go:build integration
+
+package todo
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Lysoul/gocommon/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests hit a real Postgres instance (POSTGRES_URL) and are only
+// compiled in with `go test -tags=integration ./...`.
+func TestRepositoryIntegration(t *testing.T) {
+	url := os.Getenv("POSTGRES_URL")
+	if url == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+
+	db, err := postgres.Open(postgres.Config{URL: url})
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	todo := &Todo{Title: "integration test todo"}
+	require.NoError(t, repo.Create(ctx, todo))
+	require.NotEqual(t, "", todo.ID.String())
+
+	fetched, err := repo.Get(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, todo.Title, fetched.Title)
+
+	fetched.Status = StatusDone
+	require.NoError(t, repo.Update(ctx, fetched))
+
+	updated, err := repo.Get(ctx, todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, StatusDone, updated.Status)
+
+	require.NoError(t, repo.Delete(ctx, todo.ID))
+
+	_, err = repo.Get(ctx, todo.ID)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,40 @@
+package todo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Status is the lifecycle state of a Todo.
+type Status string
+
+const (
+	StatusPending    Status = "pending"
+	StatusInProgress Status = "in_progress"
+	StatusDone       Status = "done"
+)
+
+// Valid reports whether s is one of the known statuses.
+func (s Status) Valid() bool {
+	switch s {
+	case StatusPending, StatusInProgress, StatusDone:
+		return true
+	default:
+		return false
+	}
+}
+
+// Todo is a single task tracked by the service.
+type Todo struct {
+	bun.BaseModel `bun:"table:todos"`
+
+	ID          uuid.UUID  `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	Title       string     `bun:"title,notnull" json:"title"`
+	Description string     `bun:"description,notnull" json:"description"`
+	Status      Status     `bun:"status,notnull" json:"status"`
+	DueAt       *time.Time `bun:"due_at" json:"due_at,omitempty"`
+	CreatedAt   time.Time  `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt   time.Time  `bun:"updated_at,notnull,default:current_timestamp" json:"updated_at"`
+}
@@ -0,0 +1,70 @@
+package httperr
+
+import (
+	"strings"
+
+	"github.com/Lysoul/gocommon/monitoring"
+	"github.com/Lysoul/todolist/pkg/observability"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// HandlerFunc is a gin handler that reports failure by returning an error
+// instead of writing the response itself; Wrap renders it as a problem
+// document.
+type HandlerFunc func(c *gin.Context) error
+
+// Wrap adapts a HandlerFunc to gin.HandlerFunc, rendering any returned
+// error as a problem+json response.
+func Wrap(h HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := h(c); err != nil {
+			Render(c, err)
+		}
+	}
+}
+
+// Render writes err to c as an RFC 7807 problem document, filling in the
+// request path and trace ID if one is present on the context.
+func Render(c *gin.Context, err error) {
+	p := From(err)
+	if p.Instance == "" {
+		p.Instance = c.Request.URL.Path
+	}
+	if sc := trace.SpanContextFromContext(c.Request.Context()); sc.IsValid() {
+		p.TraceID = sc.TraceID().String()
+	}
+
+	c.Header("Content-Type", contentType(c)+"; charset=utf-8")
+	c.AbortWithStatusJSON(p.Status, p)
+}
+
+// contentType honors Accept: an Accept header that is absent, names
+// application/problem+json or allows */* gets problem+json; any other
+// Accept gets a plain application/json response with the same body.
+func contentType(c *gin.Context) string {
+	accept := c.GetHeader("Accept")
+	if accept == "" || strings.Contains(accept, "application/problem+json") || strings.Contains(accept, "*/*") {
+		return "application/problem+json"
+	}
+	return "application/json"
+}
+
+// Recovery recovers any panic from the handler chain, logs it with the
+// stack trace, and renders a 500 problem document without leaking the
+// panic value or stack to the client.
+func Recovery() gin.HandlerFunc {
+	log := monitoring.Logger()
+
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				observability.LoggerWithTrace(c.Request.Context(), log).Error("panic recovered",
+					zap.Any("panic", r), zap.Stack("stack"))
+				Render(c, New(500, "Internal server error", ""))
+			}
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,87 @@
+// Package httperr gives every HTTP handler a single, consistent error
+// model: RFC 7807 problem+json responses, with domain errors (validation,
+// conflict, not found, forbidden) mapped to the right status automatically.
+package httperr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel domain errors handlers can wrap with fmt.Errorf("...: %w", ...)
+// to get the right status code without constructing a Problem by hand.
+var (
+	ErrValidation = errors.New("validation failed")
+	ErrNotFound   = errors.New("not found")
+	ErrConflict   = errors.New("conflict")
+	ErrForbidden  = errors.New("forbidden")
+)
+
+// Problem is an RFC 7807 application/problem+json body.
+type Problem struct {
+	Type     string            `json:"type"`
+	Title    string            `json:"title"`
+	Status   int               `json:"status"`
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty"`
+	TraceID  string            `json:"trace_id,omitempty"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
+// Error lets a *Problem satisfy the error interface, so a handler can
+// `return httperr.NotFound("todo", id)` directly.
+func (p *Problem) Error() string {
+	return p.Title
+}
+
+// New builds a Problem with type "about:blank", the RFC 7807 default for
+// a problem that doesn't need its own registered type URI.
+func New(status int, title, detail string) *Problem {
+	return &Problem{Type: "about:blank", Title: title, Status: status, Detail: detail}
+}
+
+// NotFound builds a 404 Problem for a missing resource.
+func NotFound(resource, id string) *Problem {
+	return New(http.StatusNotFound, "Not found", fmt.Sprintf("%s %s not found", resource, id))
+}
+
+// Validation builds a 400 Problem, optionally with per-field errors.
+func Validation(detail string, fields map[string]string) *Problem {
+	p := New(http.StatusBadRequest, "Validation failed", detail)
+	p.Errors = fields
+	return p
+}
+
+// Conflict builds a 409 Problem.
+func Conflict(detail string) *Problem {
+	return New(http.StatusConflict, "Conflict", detail)
+}
+
+// Forbidden builds a 403 Problem.
+func Forbidden(detail string) *Problem {
+	return New(http.StatusForbidden, "Forbidden", detail)
+}
+
+// From maps any error to a Problem: a *Problem passes through unchanged,
+// a wrapped sentinel becomes the matching status, and anything else
+// becomes a 500 with no detail leaked to the client.
+func From(err error) *Problem {
+	var p *Problem
+	if errors.As(err, &p) {
+		return p
+	}
+
+	switch {
+	case errors.Is(err, ErrValidation):
+		return New(http.StatusBadRequest, "Validation failed", err.Error())
+	case errors.Is(err, ErrNotFound):
+		return New(http.StatusNotFound, "Not found", err.Error())
+	case errors.Is(err, ErrConflict):
+		return New(http.StatusConflict, "Conflict", err.Error())
+	case errors.Is(err, ErrForbidden):
+		return New(http.StatusForbidden, "Forbidden", err.Error())
+	default:
+		return New(http.StatusInternalServerError, "Internal server error", "")
+	}
+}
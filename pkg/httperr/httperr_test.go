@@ -0,0 +1,41 @@
+package httperr
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"a Problem passes through unchanged", NotFound("todo", "123"), http.StatusNotFound},
+		{"wrapped ErrValidation maps to 400", fmt.Errorf("title: %w", ErrValidation), http.StatusBadRequest},
+		{"wrapped ErrNotFound maps to 404", fmt.Errorf("todo: %w", ErrNotFound), http.StatusNotFound},
+		{"wrapped ErrConflict maps to 409", fmt.Errorf("slug: %w", ErrConflict), http.StatusConflict},
+		{"wrapped ErrForbidden maps to 403", fmt.Errorf("owner: %w", ErrForbidden), http.StatusForbidden},
+		{"an unknown error maps to 500 without detail", fmt.Errorf("boom"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := From(tc.err)
+			assert.Equal(t, tc.wantStatus, p.Status)
+		})
+	}
+
+	t.Run("unknown errors don't leak their detail", func(t *testing.T) {
+		p := From(fmt.Errorf("sensitive internal detail"))
+		assert.Empty(t, p.Detail)
+	})
+}
+
+func TestProblemSatisfiesError(t *testing.T) {
+	var err error = NotFound("todo", "123")
+	assert.EqualError(t, err, "Not found")
+}
@@ -0,0 +1,170 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/Lysoul/gocommon/postgres"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/uptrace/bun"
+	"github.com/urfave/cli/v2"
+)
+
+// CliCommand exposes "migrate status|up|down|redo" so operators can run
+// migrations out-of-band, independent of POSTGRES_MIGRATE at app startup.
+func CliCommand() *cli.Command {
+	dryRunFlag := &cli.BoolFlag{Name: "dry-run", Usage: "print what would change without applying it"}
+
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "Apply or inspect migrations out-of-band",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "status",
+				Usage:  "Show applied vs pending migrations",
+				Action: runStatus,
+			},
+			{
+				Name:   "up",
+				Usage:  "Apply pending migrations",
+				Flags:  []cli.Flag{dryRunFlag},
+				Action: runUp,
+			},
+			{
+				Name:   "down",
+				Usage:  "Revert the most recently applied migration",
+				Flags:  []cli.Flag{dryRunFlag},
+				Action: runDown,
+			},
+			{
+				Name:   "redo",
+				Usage:  "Revert and reapply the most recently applied migration",
+				Action: runRedo,
+			},
+		},
+	}
+}
+
+func newMigrator() (*Migrator, *bun.DB, error) {
+	var pgConfig postgres.Config
+	envconfig.MustProcess("", &pgConfig)
+
+	db, err := postgres.Open(pgConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("migrate: connecting to database: %w", err)
+	}
+
+	return New(db), db, nil
+}
+
+func runStatus(c *cli.Context) error {
+	m, db, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	entries, err := m.Status(c.Context)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, e := range entries {
+		appliedAt := "pending"
+		if e.AppliedAt != nil {
+			appliedAt = e.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", e.Version, e.Name, appliedAt)
+	}
+
+	return w.Flush()
+}
+
+func runUp(c *cli.Context) error {
+	m, db, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	dryRun := c.Bool("dry-run")
+	applied, err := m.Up(c.Context, dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, mg := range applied {
+		fmt.Println(formatUpEntry(mg, dryRun))
+	}
+
+	return nil
+}
+
+func runDown(c *cli.Context) error {
+	m, db, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	dryRun := c.Bool("dry-run")
+	reverted, err := m.Down(c.Context, dryRun)
+	if err != nil {
+		return err
+	}
+
+	if reverted == nil {
+		fmt.Println("nothing to revert")
+		return nil
+	}
+
+	fmt.Println(formatDownEntry(*reverted, dryRun))
+
+	return nil
+}
+
+// formatUpEntry describes mg having been applied, or - with dryRun - only
+// planned to be, so `migrate up --dry-run`'s output can't be mistaken for a
+// real run's.
+func formatUpEntry(mg StatusEntry, dryRun bool) string {
+	verb := "applied"
+	if dryRun {
+		verb = "would apply"
+	}
+	return fmt.Sprintf("%s %04d_%s", verb, mg.Version, mg.Name)
+}
+
+// formatDownEntry describes mg having been reverted, or - with dryRun -
+// only planned to be.
+func formatDownEntry(mg StatusEntry, dryRun bool) string {
+	verb := "reverted"
+	if dryRun {
+		verb = "would revert"
+	}
+	return fmt.Sprintf("%s %04d_%s", verb, mg.Version, mg.Name)
+}
+
+func runRedo(c *cli.Context) error {
+	m, db, err := newMigrator()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	redone, err := m.Redo(c.Context)
+	if err != nil {
+		return err
+	}
+
+	if redone == nil {
+		fmt.Println("nothing to redo")
+		return nil
+	}
+
+	fmt.Printf("redid %04d_%s\n", redone.Version, redone.Name)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUpEntry(t *testing.T) {
+	entry := StatusEntry{Version: 2, Name: "add_index"}
+
+	assert.Equal(t, "applied 0002_add_index", formatUpEntry(entry, false))
+	assert.Equal(t, "would apply 0002_add_index", formatUpEntry(entry, true),
+		"dry-run output must not be mistaken for a real apply")
+}
+
+func TestFormatDownEntry(t *testing.T) {
+	entry := StatusEntry{Version: 2, Name: "add_index"}
+
+	assert.Equal(t, "reverted 0002_add_index", formatDownEntry(entry, false))
+	assert.Equal(t, "would revert 0002_add_index", formatDownEntry(entry, true),
+		"dry-run output must not be mistaken for a real revert")
+}
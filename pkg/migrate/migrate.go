@@ -0,0 +1,181 @@
+// Package migrate applies the embedded SQL migrations out-of-band of app
+// startup (see the "migrate" CLI subcommand). It drives the same
+// db/migrations.Migration set, through the same bun_migrations bookkeeping
+// table as app startup and the migrate-list CLI, so this tool and the
+// running service never disagree about what's applied. Up and Down hold
+// bun's migration lock while they change the schema, and app startup
+// applies migrations through Up as well.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lysoul/todolist/db/migrations"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+)
+
+// StatusEntry reports whether a migration has been applied.
+type StatusEntry struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+// Migrator applies and inspects the embedded migration set against db.
+type Migrator struct {
+	db *bun.DB
+}
+
+// New returns a Migrator for db.
+func New(db *bun.DB) *Migrator {
+	return &Migrator{db: db}
+}
+
+// Status reports, for every embedded migration, whether it has been
+// applied and when.
+func (m *Migrator) Status(ctx context.Context) ([]StatusEntry, error) {
+	bunMigrator := migrate.NewMigrator(m.db, migrations.Migration)
+	if err := bunMigrator.Init(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: initializing migrator: %w", err)
+	}
+
+	applied, err := bunMigrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: fetching migration status: %w", err)
+	}
+
+	entries := make([]StatusEntry, 0, len(applied))
+	for _, mg := range applied {
+		entries = append(entries, toStatusEntry(mg))
+	}
+
+	return entries, nil
+}
+
+// Up applies every pending migration, one file at a time, via
+// migrations.TimedMigrator so a hung migration logs the same start/done/slow
+// pair whether it's triggered from this CLI or app startup. It holds bun's
+// migration lock while applying, so a second caller fails to take the lock
+// instead of applying the same files again. With dryRun it reports which
+// migrations would be applied without running any of them.
+func (m *Migrator) Up(ctx context.Context, dryRun bool) ([]StatusEntry, error) {
+	if dryRun {
+		entries, err := m.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var pending []StatusEntry
+		for _, e := range entries {
+			if e.AppliedAt == nil {
+				pending = append(pending, e)
+			}
+		}
+		return pending, nil
+	}
+
+	bunMigrator := migrate.NewMigrator(m.db, migrations.Migration)
+	if err := bunMigrator.Init(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: initializing migrator: %w", err)
+	}
+	if err := bunMigrator.Lock(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: acquiring lock: %w", err)
+	}
+	defer bunMigrator.Unlock(ctx) //nolint:errcheck // best-effort release
+
+	group, err := migrations.NewTimedMigrator(m.db).Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: applying migrations: %w", err)
+	}
+
+	applied := make([]StatusEntry, 0, len(group.Migrations))
+	for _, mg := range group.Migrations {
+		applied = append(applied, StatusEntry{Version: mg.ID, Name: mg.Name})
+	}
+
+	return applied, nil
+}
+
+// Down reverts the single most recently applied migration group. With
+// dryRun it reports which migration would be reverted without committing
+// anything.
+func (m *Migrator) Down(ctx context.Context, dryRun bool) (*StatusEntry, error) {
+	bunMigrator := migrate.NewMigrator(m.db, migrations.Migration)
+	if err := bunMigrator.Init(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: initializing migrator: %w", err)
+	}
+
+	if dryRun {
+		applied, err := bunMigrator.MigrationsWithStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("migrate: fetching migration status: %w", err)
+		}
+
+		last := lastApplied(applied)
+		if last == nil {
+			return nil, nil
+		}
+		entry := toStatusEntry(*last)
+		return &entry, nil
+	}
+
+	if err := bunMigrator.Lock(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: acquiring lock: %w", err)
+	}
+	defer bunMigrator.Unlock(ctx) //nolint:errcheck // best-effort release
+
+	group, err := bunMigrator.Rollback(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: reverting migration: %w", err)
+	}
+	if len(group.Migrations) == 0 {
+		return nil, nil
+	}
+
+	entry := toStatusEntry(group.Migrations[0])
+	return &entry, nil
+}
+
+// Redo reverts and reapplies the most recently applied migration.
+func (m *Migrator) Redo(ctx context.Context) (*StatusEntry, error) {
+	reverted, err := m.Down(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if reverted == nil {
+		return nil, nil
+	}
+
+	if _, err := m.Up(ctx, false); err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+func toStatusEntry(mg migrate.Migration) StatusEntry {
+	entry := StatusEntry{Version: mg.ID, Name: mg.Name}
+	if !mg.MigratedAt.IsZero() {
+		appliedAt := mg.MigratedAt
+		entry.AppliedAt = &appliedAt
+	}
+	return entry
+}
+
+// lastApplied returns the highest-versioned applied migration in ms, or nil
+// if none have been applied yet.
+func lastApplied(ms migrate.MigrationSlice) *migrate.Migration {
+	var last *migrate.Migration
+	for i := range ms {
+		if ms[i].MigratedAt.IsZero() {
+			continue
+		}
+		if last == nil || ms[i].ID > last.ID {
+			last = &ms[i]
+		}
+	}
+	return last
+}
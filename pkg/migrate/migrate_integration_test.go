@@ -0,0 +1,55 @@
+//go:build integration
+
+package migrate
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Lysoul/gocommon/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigratorRoundTrip hits a real Postgres instance (POSTGRES_URL) and
+// confirms Up/Down/Redo track state through the same bun_migrations table
+// db/migrations and the app use, not a parallel one.
+func TestMigratorRoundTrip(t *testing.T) {
+	url := os.Getenv("POSTGRES_URL")
+	if url == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+
+	db, err := postgres.Open(postgres.Config{URL: url})
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	m := New(db)
+
+	applied, err := m.Up(ctx, false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for range applied {
+			_, _ = m.Down(ctx, false)
+		}
+	})
+
+	status, err := m.Status(ctx)
+	require.NoError(t, err)
+	for _, e := range status {
+		require.NotNil(t, e.AppliedAt, "%s should be applied after Up", e.Name)
+	}
+
+	if len(applied) == 0 {
+		t.Skip("nothing to revert; migrations were already applied")
+	}
+
+	reverted, err := m.Down(ctx, false)
+	require.NoError(t, err)
+	require.NotNil(t, reverted)
+
+	redone, err := m.Redo(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, redone)
+}
@@ -0,0 +1,46 @@
+package migrate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun/migrate"
+)
+
+func TestLastApplied(t *testing.T) {
+	now := time.Now()
+
+	t.Run("nil when nothing applied", func(t *testing.T) {
+		ms := migrate.MigrationSlice{{ID: 1, Name: "create_todos"}}
+		assert.Nil(t, lastApplied(ms))
+	})
+
+	t.Run("picks the highest applied version, not insertion order", func(t *testing.T) {
+		ms := migrate.MigrationSlice{
+			{ID: 1, Name: "create_todos", MigratedAt: now},
+			{ID: 3, Name: "add_index", MigratedAt: now},
+			{ID: 2, Name: "add_column"},
+		}
+
+		last := lastApplied(ms)
+		if assert.NotNil(t, last) {
+			assert.Equal(t, int64(3), last.ID)
+		}
+	})
+}
+
+func TestToStatusEntry(t *testing.T) {
+	t.Run("pending migration has no applied-at", func(t *testing.T) {
+		entry := toStatusEntry(migrate.Migration{ID: 1, Name: "create_todos"})
+		assert.Nil(t, entry.AppliedAt)
+	})
+
+	t.Run("applied migration carries its timestamp", func(t *testing.T) {
+		now := time.Now()
+		entry := toStatusEntry(migrate.Migration{ID: 1, Name: "create_todos", MigratedAt: now})
+		if assert.NotNil(t, entry.AppliedAt) {
+			assert.True(t, entry.AppliedAt.Equal(now))
+		}
+	})
+}
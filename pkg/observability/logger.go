@@ -0,0 +1,24 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// LoggerWithTrace returns log with trace_id/span_id fields attached when
+// ctx carries a valid span context, so every log line inside a traced
+// request can be correlated back to it. If ctx has no active span, log is
+// returned unchanged.
+func LoggerWithTrace(ctx context.Context, log *zap.Logger) *zap.Logger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return log
+	}
+
+	return log.With(
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()),
+	)
+}
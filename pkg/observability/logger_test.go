@@ -0,0 +1,46 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLoggerWithTrace(t *testing.T) {
+	t.Run("returns the logger unchanged without a span in context", func(t *testing.T) {
+		log := zap.NewNop()
+
+		got := LoggerWithTrace(context.Background(), log)
+
+		assert.Same(t, log, got)
+	})
+
+	t.Run("attaches trace and span IDs when a valid span is present", func(t *testing.T) {
+		core, logs := observer.New(zap.InfoLevel)
+		log := zap.New(core)
+
+		traceID, err := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+		require.NoError(t, err)
+		spanID, err := trace.SpanIDFromHex("0102030405060708")
+		require.NoError(t, err)
+
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		tracedLog := LoggerWithTrace(ctx, log)
+		tracedLog.Info("hello")
+
+		require.Equal(t, 1, logs.Len())
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, traceID.String(), fields["trace_id"])
+		assert.Equal(t, spanID.String(), fields["span_id"])
+	})
+}
@@ -0,0 +1,48 @@
+package observability
+
+import (
+	"github.com/Lysoul/gocommon/monitoring"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// GinMiddleware starts a span for every request, named after the matched
+// route, tags it with the resulting status code, and logs the request's
+// outcome through LoggerWithTrace so the log line carries the same trace
+// ID as the span.
+func GinMiddleware(serviceName string) gin.HandlerFunc {
+	tracer := otel.Tracer(serviceName)
+	log := monitoring.Logger()
+
+	return func(c *gin.Context) {
+		spanName := c.FullPath()
+		if spanName == "" {
+			spanName = c.Request.URL.Path
+		}
+
+		ctx, span := tracer.Start(c.Request.Context(), spanName, trace.WithAttributes(
+			attribute.String("http.method", c.Request.Method),
+			attribute.String("http.target", c.Request.URL.Path),
+		))
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, "http server error")
+		}
+
+		LoggerWithTrace(ctx, log).Info("http.request",
+			zap.String("method", c.Request.Method),
+			zap.String("path", spanName),
+			zap.Int("status", status),
+		)
+	}
+}
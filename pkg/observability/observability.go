@@ -0,0 +1,112 @@
+// Package observability wires OpenTelemetry tracing and metrics for the
+// service: OTLP exporters driven by the TRACE_* config, runtime/process
+// metrics, HTTP middleware, a bun query hook, and a logger adapter that
+// stamps trace/span IDs onto every log line.
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/host"
+	"go.opentelemetry.io/contrib/instrumentation/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+// Config is the subset of the service's TRACE_* env vars this package
+// needs. It mirrors config.Trace so callers can pass that straight in.
+type Config struct {
+	ServiceName  string  `envconfig:"SERVICE_NAME" default:"todolist"`
+	CollectorURL string  `envconfig:"TRACE_COLLECTOR_URL"`
+	Insecure     bool    `envconfig:"TRACE_INSECURE" default:"false"`
+	SampleRatio  float64 `envconfig:"TRACE_SAMPLE_RATIO" default:"1.0"`
+
+	// ServiceVersion is set programmatically from app.Version rather
+	// than read from the environment.
+	ServiceVersion string `envconfig:"-"`
+}
+
+// Provider owns the tracer and meter providers installed as the process
+// globals; call Shutdown to flush and release them.
+type Provider struct {
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *metric.MeterProvider
+}
+
+// Init builds an OTLP (gRPC) trace exporter and a metrics provider from
+// cfg, installs both as the global otel providers, and returns a Provider
+// whose Shutdown flushes them.
+func Init(ctx context.Context, cfg Config) (*Provider, error) {
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.ServiceName),
+			semconv.ServiceVersion(cfg.ServiceVersion),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("observability: building resource: %w", err)
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.CollectorURL)}
+	if cfg.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("observability: creating OTLP exporter: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	metricExporterOpts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.CollectorURL)}
+	if cfg.Insecure {
+		metricExporterOpts = append(metricExporterOpts, otlpmetricgrpc.WithInsecure())
+	}
+
+	metricExporter, err := otlpmetricgrpc.New(ctx, metricExporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("observability: creating OTLP metric exporter: %w", err)
+	}
+
+	meterProvider := metric.NewMeterProvider(
+		metric.WithResource(res),
+		metric.WithReader(metric.NewPeriodicReader(metricExporter)),
+	)
+	otel.SetMeterProvider(meterProvider)
+
+	if err := runtime.Start(runtime.WithMeterProvider(meterProvider)); err != nil {
+		return nil, fmt.Errorf("observability: starting runtime metrics: %w", err)
+	}
+	if err := host.Start(host.WithMeterProvider(meterProvider)); err != nil {
+		return nil, fmt.Errorf("observability: starting process metrics: %w", err)
+	}
+
+	return &Provider{tracerProvider: tracerProvider, meterProvider: meterProvider}, nil
+}
+
+// Shutdown flushes and stops both providers, returning a combined error if
+// either fails.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs []error
+	if err := p.tracerProvider.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("tracer provider: %w", err))
+	}
+	if err := p.meterProvider.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("meter provider: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
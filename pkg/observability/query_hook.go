@@ -0,0 +1,45 @@
+package observability
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryHook is a bun.QueryHook that wraps every query in a "db.query" span
+// carrying the SQL text as db.statement, so the Postgres layer shows up in traces
+// alongside the HTTP spans from GinMiddleware. Install it with
+// db.AddQueryHook on the *bun.DB returned by postgres.Open.
+type QueryHook struct {
+	tracer trace.Tracer
+}
+
+// NewQueryHook returns a QueryHook for serviceName.
+func NewQueryHook(serviceName string) *QueryHook {
+	return &QueryHook{tracer: otel.Tracer(serviceName)}
+}
+
+type queryHookSpanKey struct{}
+
+// BeforeQuery implements bun.QueryHook.
+func (h *QueryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	ctx, span := h.tracer.Start(ctx, "db.query", trace.WithAttributes(
+		attribute.String("db.statement", event.Query),
+	))
+	return context.WithValue(ctx, queryHookSpanKey{}, span)
+}
+
+// AfterQuery implements bun.QueryHook.
+func (h *QueryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	span, ok := ctx.Value(queryHookSpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	if event.Err != nil {
+		span.RecordError(event.Err)
+	}
+	span.End()
+}